@@ -0,0 +1,200 @@
+package slack
+
+import "encoding/json"
+
+// Block is a single Block Kit layout block. Concrete implementations set
+// their own "type" discriminator when marshaled to JSON.
+// See https://api.slack.com/reference/block-kit/blocks for details.
+type Block interface {
+	blockType() string
+}
+
+// TextObject represents a Block Kit text composition object, either plain
+// text or Slack's mrkdwn.
+// See https://api.slack.com/reference/block-kit/composition-objects#text.
+type TextObject struct {
+	Type     string `json:"type"` // "plain_text" or "mrkdwn"
+	Text     string `json:"text"`
+	Emoji    bool   `json:"emoji,omitempty"`    // plain_text only
+	Verbatim bool   `json:"verbatim,omitempty"` // mrkdwn only
+}
+
+// PlainText returns a TextObject of type plain_text.
+func PlainText(text string) *TextObject {
+	return &TextObject{Type: "plain_text", Text: text}
+}
+
+// MarkdownText returns a TextObject of type mrkdwn.
+func MarkdownText(text string) *TextObject {
+	return &TextObject{Type: "mrkdwn", Text: text}
+}
+
+// elementType lets a TextObject be used as a ContextBlock element.
+func (t TextObject) elementType() string { return t.Type }
+
+// ImageElement is a Block Kit image element, used inside ContextBlock or as
+// an accessory.
+// See https://api.slack.com/reference/block-kit/block-elements#image.
+type ImageElement struct {
+	ImageURL string `json:"image_url"`
+	AltText  string `json:"alt_text"`
+}
+
+func (ImageElement) elementType() string { return "image" }
+
+// MarshalJSON implements json.Marshaler, adding the "type" discriminator
+// Slack expects on every element.
+func (e ImageElement) MarshalJSON() ([]byte, error) {
+	type alias ImageElement
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		alias
+	}{Type: e.elementType(), alias: alias(e)})
+}
+
+// ButtonElement is a Block Kit interactive button element.
+// See https://api.slack.com/reference/block-kit/block-elements#button.
+type ButtonElement struct {
+	Text     *TextObject `json:"text"`
+	ActionID string      `json:"action_id,omitempty"`
+	URL      string      `json:"url,omitempty"`
+	Value    string      `json:"value,omitempty"`
+	Style    string      `json:"style,omitempty"` // "primary" or "danger"
+}
+
+func (ButtonElement) elementType() string { return "button" }
+
+// MarshalJSON implements json.Marshaler, adding the "type" discriminator
+// Slack expects on every element.
+func (e ButtonElement) MarshalJSON() ([]byte, error) {
+	type alias ButtonElement
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		alias
+	}{Type: e.elementType(), alias: alias(e)})
+}
+
+// BlockElement is a Block Kit element usable inside an ActionsBlock or
+// ContextBlock, such as ButtonElement or ImageElement.
+type BlockElement interface {
+	elementType() string
+}
+
+// SectionBlock is a Block Kit section block, the most flexible block type.
+// See https://api.slack.com/reference/block-kit/blocks#section.
+type SectionBlock struct {
+	Text      *TextObject   `json:"text,omitempty"`
+	Fields    []*TextObject `json:"fields,omitempty"`
+	Accessory BlockElement  `json:"accessory,omitempty"`
+	BlockID   string        `json:"block_id,omitempty"`
+}
+
+func (SectionBlock) blockType() string { return "section" }
+
+// MarshalJSON implements json.Marshaler, adding the "type" discriminator
+// Slack expects on every block.
+func (b SectionBlock) MarshalJSON() ([]byte, error) {
+	type alias SectionBlock
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		alias
+	}{Type: b.blockType(), alias: alias(b)})
+}
+
+// DividerBlock is a Block Kit divider block, a simple visual separator.
+// See https://api.slack.com/reference/block-kit/blocks#divider.
+type DividerBlock struct {
+	BlockID string `json:"block_id,omitempty"`
+}
+
+func (DividerBlock) blockType() string { return "divider" }
+
+// MarshalJSON implements json.Marshaler, adding the "type" discriminator
+// Slack expects on every block.
+func (b DividerBlock) MarshalJSON() ([]byte, error) {
+	type alias DividerBlock
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		alias
+	}{Type: b.blockType(), alias: alias(b)})
+}
+
+// HeaderBlock is a Block Kit header block, a single bold plain-text line.
+// See https://api.slack.com/reference/block-kit/blocks#header.
+type HeaderBlock struct {
+	Text    *TextObject `json:"text"`
+	BlockID string      `json:"block_id,omitempty"`
+}
+
+func (HeaderBlock) blockType() string { return "header" }
+
+// MarshalJSON implements json.Marshaler, adding the "type" discriminator
+// Slack expects on every block.
+func (b HeaderBlock) MarshalJSON() ([]byte, error) {
+	type alias HeaderBlock
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		alias
+	}{Type: b.blockType(), alias: alias(b)})
+}
+
+// ContextBlock is a Block Kit context block, displaying small text and/or
+// image elements.
+// See https://api.slack.com/reference/block-kit/blocks#context.
+type ContextBlock struct {
+	Elements []BlockElement `json:"elements"`
+	BlockID  string         `json:"block_id,omitempty"`
+}
+
+func (ContextBlock) blockType() string { return "context" }
+
+// MarshalJSON implements json.Marshaler, adding the "type" discriminator
+// Slack expects on every block.
+func (b ContextBlock) MarshalJSON() ([]byte, error) {
+	type alias ContextBlock
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		alias
+	}{Type: b.blockType(), alias: alias(b)})
+}
+
+// ActionsBlock is a Block Kit actions block, holding up to 25 interactive
+// elements such as buttons.
+// See https://api.slack.com/reference/block-kit/blocks#actions.
+type ActionsBlock struct {
+	Elements []BlockElement `json:"elements"`
+	BlockID  string         `json:"block_id,omitempty"`
+}
+
+func (ActionsBlock) blockType() string { return "actions" }
+
+// MarshalJSON implements json.Marshaler, adding the "type" discriminator
+// Slack expects on every block.
+func (b ActionsBlock) MarshalJSON() ([]byte, error) {
+	type alias ActionsBlock
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		alias
+	}{Type: b.blockType(), alias: alias(b)})
+}
+
+// ImageBlock is a Block Kit image block, displaying a standalone image.
+// See https://api.slack.com/reference/block-kit/blocks#image.
+type ImageBlock struct {
+	ImageURL string      `json:"image_url"`
+	AltText  string      `json:"alt_text"`
+	Title    *TextObject `json:"title,omitempty"`
+	BlockID  string      `json:"block_id,omitempty"`
+}
+
+func (ImageBlock) blockType() string { return "image" }
+
+// MarshalJSON implements json.Marshaler, adding the "type" discriminator
+// Slack expects on every block.
+func (b ImageBlock) MarshalJSON() ([]byte, error) {
+	type alias ImageBlock
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		alias
+	}{Type: b.blockType(), alias: alias(b)})
+}
@@ -0,0 +1,88 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBlockMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		give Block
+		want string
+	}{
+		{
+			name: "SectionBlock",
+			give: SectionBlock{Text: MarkdownText("hello")},
+			want: `{"type":"section","text":{"type":"mrkdwn","text":"hello"}}`,
+		},
+		{
+			name: "DividerBlock",
+			give: DividerBlock{},
+			want: `{"type":"divider"}`,
+		},
+		{
+			name: "HeaderBlock",
+			give: HeaderBlock{Text: PlainText("Deploy finished")},
+			want: `{"type":"header","text":{"type":"plain_text","text":"Deploy finished"}}`,
+		},
+		{
+			name: "ContextBlock",
+			give: ContextBlock{Elements: []BlockElement{ImageElement{ImageURL: "https://example.com/i.png", AltText: "icon"}}},
+			want: `{"type":"context","elements":[{"type":"image","image_url":"https://example.com/i.png","alt_text":"icon"}]}`,
+		},
+		{
+			name: "ActionsBlock",
+			give: ActionsBlock{Elements: []BlockElement{ButtonElement{Text: PlainText("Approve"), ActionID: "approve"}}},
+			want: `{"type":"actions","elements":[{"type":"button","text":{"type":"plain_text","text":"Approve"},"action_id":"approve"}]}`,
+		},
+		{
+			name: "ImageBlock",
+			give: ImageBlock{ImageURL: "https://example.com/i.png", AltText: "icon"},
+			want: `{"type":"image","image_url":"https://example.com/i.png","alt_text":"icon"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.give)
+			if err != nil {
+				t.Fatalf("json.Marshal returned an error: %s", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockElementMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		give BlockElement
+		want string
+	}{
+		{
+			name: "ButtonElement",
+			give: ButtonElement{Text: PlainText("Approve"), ActionID: "approve"},
+			want: `{"type":"button","text":{"type":"plain_text","text":"Approve"},"action_id":"approve"}`,
+		},
+		{
+			name: "ImageElement",
+			give: ImageElement{ImageURL: "https://example.com/i.png", AltText: "icon"},
+			want: `{"type":"image","image_url":"https://example.com/i.png","alt_text":"icon"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.give)
+			if err != nil {
+				t.Fatalf("json.Marshal returned an error: %s", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Package notify provides ready-made Slack notifications for CI/CD build
+// status events, built on top of the primitives in the slack package.
+package notify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/iocaste/slack"
+)
+
+// Status values accepted by BuildEvent.Status and Send.
+const (
+	StatusStarted   = "started"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// Attachment colors matching Slack's conventional build status palette.
+const (
+	colorGray  = "#cccccc"
+	colorGreen = "#36a64f"
+	colorRed   = "#dc3545"
+)
+
+// BuildEvent describes a single build for a CI/CD pipeline notification.
+type BuildEvent struct {
+	Repo            string
+	Branch          string
+	Commit          string
+	Author          string
+	URL             string
+	Status          string
+	DurationSeconds int
+}
+
+// NotifyOn selects which build events a pipeline should notify on.
+type NotifyOn struct {
+	OnStarted bool
+	OnSuccess bool
+	OnFailure bool
+}
+
+// Send builds the appropriate message for event.Status and sends it via c,
+// honoring cfg. It is a no-op (returning nil) when cfg disables the given
+// status. Status must be one of StatusStarted, StatusSucceeded, or
+// StatusFailed.
+func Send(c *slack.Client, cfg NotifyOn, event BuildEvent) error {
+	var message *slack.Message
+	switch event.Status {
+	case StatusStarted:
+		if !cfg.OnStarted {
+			return nil
+		}
+		message = BuildStarted(event)
+	case StatusSucceeded:
+		if !cfg.OnSuccess {
+			return nil
+		}
+		message = BuildSucceeded(event)
+	case StatusFailed:
+		if !cfg.OnFailure {
+			return nil
+		}
+		message = BuildFailed(event)
+	default:
+		return fmt.Errorf("notify: unknown build status %q", event.Status)
+	}
+	return c.Send(message)
+}
+
+// BuildStarted returns a gray-attachment message announcing that a build
+// has started.
+func BuildStarted(event BuildEvent) *slack.Message {
+	return buildMessage(event, colorGray, fmt.Sprintf("Build started for %s", event.Repo))
+}
+
+// BuildSucceeded returns a green-attachment message announcing that a build
+// succeeded.
+func BuildSucceeded(event BuildEvent) *slack.Message {
+	return buildMessage(event, colorGreen, fmt.Sprintf("Build succeeded for %s", event.Repo))
+}
+
+// BuildFailed returns a red-attachment message announcing that a build
+// failed.
+func BuildFailed(event BuildEvent) *slack.Message {
+	return buildMessage(event, colorRed, fmt.Sprintf("Build failed for %s", event.Repo))
+}
+
+func buildMessage(event BuildEvent, color, fallback string) *slack.Message {
+	fields := []slack.AttachmentField{
+		{Title: "Branch", Value: event.Branch, Short: true},
+		{Title: "Commit", Value: event.Commit, Short: true},
+		{Title: "Author", Value: event.Author, Short: true},
+	}
+	if event.DurationSeconds > 0 {
+		fields = append(fields, slack.AttachmentField{
+			Title: "Duration",
+			Value: fmt.Sprintf("%ds", event.DurationSeconds),
+			Short: true,
+		})
+	}
+	return &slack.Message{
+		Attachments: []slack.Attachment{
+			{
+				Fallback:  fallback,
+				Color:     color,
+				Title:     fallback,
+				TitleLink: event.URL,
+				Fields:    fields,
+				Footer:    event.Repo,
+				Timestamp: time.Now().Unix(),
+			},
+		},
+	}
+}
@@ -0,0 +1,179 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default values applied by RetryPolicy when its fields are left at their
+// zero value.
+const (
+	defaultMaxAttempts = 5
+	defaultBaseDelay   = 250 * time.Millisecond
+	defaultMaxDelay    = 30 * time.Second
+	defaultJitter      = 0.2
+)
+
+// RetryPolicy controls how SendContext retries transient failures. The zero
+// value is valid and applies the package defaults.
+type RetryPolicy struct {
+	MaxAttempts int           // total attempts, including the first; default 5
+	BaseDelay   time.Duration // delay before the first retry; default 250ms
+	MaxDelay    time.Duration // cap on the exponential delay before jitter is added; default 30s
+	Jitter      float64       // fraction of the delay to randomize, in [0,1]; default 0.2
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = defaultMaxAttempts
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = defaultBaseDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = defaultMaxDelay
+	}
+	if p.Jitter <= 0 {
+		p.Jitter = defaultJitter
+	}
+	return p
+}
+
+// backoff returns the delay to wait before retry attempt n (1-based: the
+// delay before the 2nd attempt is backoff(1)).
+func (p RetryPolicy) backoff(n int) time.Duration {
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(n-1))
+	if delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	jitter := delay * p.Jitter * rand.Float64()
+	return time.Duration(delay + jitter)
+}
+
+// SlackError is returned when Slack responds with a non-2xx status,
+// carrying enough detail for callers to distinguish permanent failures
+// (4xx) from ones that exhausted their retries (5xx, 429).
+type SlackError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *SlackError) Error() string {
+	return fmt.Sprintf("Slack API returned %d: %s", e.StatusCode, e.Body)
+}
+
+// SendContext is like Send but accepts a context for cancellation/timeouts
+// and automatically retries transient failures (network errors and HTTP
+// 5xx) with exponential backoff, honoring HTTP 429's Retry-After header.
+// Retry behavior is controlled by c.RetryPolicy.
+func (c *Client) SendContext(ctx context.Context, message *Message) error {
+	if message == nil {
+		return fmt.Errorf("message is nil")
+	}
+	var b bytes.Buffer
+	if err := json.NewEncoder(&b).Encode(message); err != nil {
+		return fmt.Errorf("could not encode the message to JSON: %s", err)
+	}
+	payload := b.Bytes()
+	policy := c.RetryPolicy.withDefaults()
+
+	var lastErr error
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		waited, err := c.sendOnce(ctx, payload)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if attempt == policy.MaxAttempts {
+			break
+		}
+
+		if slackErr, ok := err.(*SlackError); ok {
+			if slackErr.StatusCode == http.StatusTooManyRequests && waited {
+				// sendOnce already slept for the Retry-After duration.
+				continue
+			}
+			if slackErr.StatusCode < 500 && slackErr.StatusCode != http.StatusTooManyRequests {
+				return err // permanent 4xx failure, don't retry
+			}
+		}
+		if err := sleep(ctx, policy.backoff(attempt)); err != nil {
+			return err
+		}
+	}
+	return lastErr
+}
+
+// sendOnce performs a single POST of payload to c.WebhookURL. On HTTP 429
+// with a usable Retry-After header, it sleeps for that duration itself
+// (returning waited=true) so the caller's normal backoff does not also
+// apply on top of it; otherwise the caller is responsible for backing off.
+func (c *Client) sendOnce(ctx context.Context, payload []byte) (waited bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.WebhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return false, fmt.Errorf("could not build the request: %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return false, err // network error: retry-able
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	slackErr := &SlackError{StatusCode: resp.StatusCode, Body: string(body)}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if err := sleep(ctx, d); err != nil {
+				return false, err
+			}
+			return true, slackErr
+		}
+	}
+	return false, slackErr
+}
+
+// parseRetryAfter parses a Retry-After header value, which per RFC 7231 is
+// either a number of seconds or an HTTP-date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sleep waits for d, returning early with ctx.Err() if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
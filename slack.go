@@ -19,6 +19,7 @@ type Message struct {
 	IconURL     string       `json:"icon_url,omitempty"`
 	Text        string       `json:"text,omitempty"`
 	Attachments []Attachment `json:"attachments,omitempty"`
+	Blocks      []Block      `json:"blocks,omitempty"`
 }
 
 // Attachment represents an attachment of a message.
@@ -54,6 +55,31 @@ type AttachmentField struct {
 type Client struct {
 	WebhookURL string       // Webhook URL (mandatory)
 	HTTPClient *http.Client // Default to http.DefaultClient
+
+	// BotToken is a Slack bot token (xoxb-...) used to authenticate calls
+	// against the slack.com/api/* Web API, such as file uploads. It is not
+	// required for Send, which only talks to WebhookURL.
+	BotToken string
+
+	// Username, IconEmoji, and IconURL are defaults that SendTemplate applies
+	// to rendered messages that don't set their own. Send and SendContext
+	// send messages as given and do not apply them.
+	Username  string
+	IconEmoji string
+	IconURL   string
+
+	// RetryPolicy controls SendContext's retry/backoff behavior. The zero
+	// value applies sensible defaults.
+	RetryPolicy RetryPolicy
+}
+
+// httpClient returns the HTTP client to use, falling back to
+// http.DefaultClient when none was configured.
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
 }
 
 // Send sends the message to Slack.
@@ -66,11 +92,7 @@ func (c *Client) Send(message *Message) error {
 	if err := json.NewEncoder(&b).Encode(message); err != nil {
 		return fmt.Errorf("Could not encode the message to JSON: %s", err)
 	}
-	hc := c.HTTPClient
-	if hc == nil {
-		hc = http.DefaultClient
-	}
-	resp, err := hc.Post(c.WebhookURL, "application/json", &b)
+	resp, err := c.httpClient().Post(c.WebhookURL, "application/json", &b)
 	if err != nil {
 		return fmt.Errorf("Could not send the request: %s", err)
 	}
@@ -0,0 +1,102 @@
+package slack
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+// Template is a Message whose Text, Username, IconEmoji, IconURL,
+// Attachments[].Text, Attachments[].Title, and Attachments[].Fields[].Value
+// are Go text/template strings, evaluated against a data value at render
+// time. Everything else is copied through to the rendered Message as-is.
+type Template struct {
+	Message
+}
+
+// Render evaluates all template strings in t against data and returns the
+// resulting Message.
+func (t *Template) Render(data interface{}) (*Message, error) {
+	m := t.Message
+
+	rendered, err := renderTemplate("text", m.Text, data)
+	if err != nil {
+		return nil, err
+	}
+	m.Text = rendered
+
+	if m.Username, err = renderTemplate("username", m.Username, data); err != nil {
+		return nil, err
+	}
+	if m.IconEmoji, err = renderTemplate("icon_emoji", m.IconEmoji, data); err != nil {
+		return nil, err
+	}
+	if m.IconURL, err = renderTemplate("icon_url", m.IconURL, data); err != nil {
+		return nil, err
+	}
+
+	if len(m.Attachments) > 0 {
+		attachments := make([]Attachment, len(m.Attachments))
+		copy(attachments, m.Attachments)
+		for i := range attachments {
+			a := &attachments[i]
+			if a.Text, err = renderTemplate("attachment.text", a.Text, data); err != nil {
+				return nil, err
+			}
+			if a.Title, err = renderTemplate("attachment.title", a.Title, data); err != nil {
+				return nil, err
+			}
+			if len(a.Fields) > 0 {
+				fields := make([]AttachmentField, len(a.Fields))
+				copy(fields, a.Fields)
+				for j := range fields {
+					if fields[j].Value, err = renderTemplate("attachment.field.value", fields[j].Value, data); err != nil {
+						return nil, err
+					}
+				}
+				a.Fields = fields
+			}
+		}
+		m.Attachments = attachments
+	}
+
+	return &m, nil
+}
+
+// renderTemplate parses and executes s as a text/template against data. An
+// empty s renders to an empty string without invoking the template engine.
+func renderTemplate(name, s string, data interface{}) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	tmpl, err := template.New(name).Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("could not parse the %s template: %s", name, err)
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("could not render the %s template: %s", name, err)
+	}
+	return b.String(), nil
+}
+
+// SendTemplate renders t against data and sends the resulting message.
+// If the rendered message does not set Username, IconEmoji, or IconURL,
+// c's defaults are applied; a non-empty value from the template always
+// takes precedence over the Client's defaults.
+func (c *Client) SendTemplate(t *Template, data interface{}) error {
+	message, err := t.Render(data)
+	if err != nil {
+		return err
+	}
+	if message.Username == "" {
+		message.Username = c.Username
+	}
+	if message.IconEmoji == "" {
+		message.IconEmoji = c.IconEmoji
+	}
+	if message.IconURL == "" {
+		message.IconURL = c.IconURL
+	}
+	return c.Send(message)
+}
@@ -0,0 +1,180 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// slackAPIURL is the base URL for the Slack Web API, used by the external
+// upload flow in place of the deprecated files.upload method.
+const slackAPIURL = "https://slack.com/api"
+
+// FileUploadRequest describes a file to be shared to a Slack channel via the
+// files.getUploadURLExternal / files.completeUploadExternal flow.
+type FileUploadRequest struct {
+	Filename  string // file name shown in Slack (mandatory)
+	Content   []byte // raw file contents
+	Title     string // optional title shown instead of Filename
+	ChannelID string // channel to share the file to
+	ThreadTS  string // optional thread timestamp to reply in
+}
+
+// FileUploadResult is the outcome of a successful UploadFile/UploadReader
+// call, parsed from the files.completeUploadExternal response.
+type FileUploadResult struct {
+	FileID    string
+	Permalink string
+	URL       string
+}
+
+// UploadFile uploads the given file and shares it to a Slack channel using
+// Slack's external upload flow (files.getUploadURLExternal,
+// an upload to the returned URL, then files.completeUploadExternal). This
+// requires c.BotToken to be set; WebhookURL is not used for uploads.
+func (c *Client) UploadFile(ctx context.Context, req *FileUploadRequest) (*FileUploadResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("upload request is nil")
+	}
+	return c.UploadReader(ctx, req.Filename, bytes.NewReader(req.Content), int64(len(req.Content)), req.Title, req.ChannelID, req.ThreadTS)
+}
+
+// UploadReader is like UploadFile but streams the file contents from r
+// instead of requiring the caller to buffer them in memory. length must be
+// the exact number of bytes r will yield.
+func (c *Client) UploadReader(ctx context.Context, filename string, r io.Reader, length int64, title, channelID, threadTS string) (*FileUploadResult, error) {
+	if c.BotToken == "" {
+		return nil, fmt.Errorf("BotToken is required to upload files")
+	}
+	if filename == "" {
+		return nil, fmt.Errorf("filename is required")
+	}
+
+	uploadURL, fileID, err := c.getUploadURLExternal(ctx, filename, length)
+	if err != nil {
+		return nil, fmt.Errorf("could not get an upload URL: %s", err)
+	}
+	if err := c.putUpload(ctx, uploadURL, r, length); err != nil {
+		return nil, fmt.Errorf("could not upload the file: %s", err)
+	}
+	return c.completeUploadExternal(ctx, fileID, title, channelID, threadTS)
+}
+
+// getUploadURLExternal is step 1: obtain a pre-signed upload URL and file ID.
+func (c *Client) getUploadURLExternal(ctx context.Context, filename string, length int64) (uploadURL, fileID string, err error) {
+	form := url.Values{
+		"filename": {filename},
+		"length":   {strconv.FormatInt(length, 10)},
+	}
+	var out struct {
+		OK        bool   `json:"ok"`
+		Error     string `json:"error"`
+		UploadURL string `json:"upload_url"`
+		FileID    string `json:"file_id"`
+	}
+	if err := c.callAPI(ctx, "files.getUploadURLExternal", strings.NewReader(form.Encode()), "application/x-www-form-urlencoded", &out); err != nil {
+		return "", "", err
+	}
+	if !out.OK {
+		return "", "", fmt.Errorf("slack API error: %s", out.Error)
+	}
+	return out.UploadURL, out.FileID, nil
+}
+
+// putUpload is step 2: send the raw file bytes to the pre-signed upload URL.
+func (c *Client) putUpload(ctx context.Context, uploadURL string, r io.Reader, length int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL, r)
+	if err != nil {
+		return fmt.Errorf("could not build the upload request: %s", err)
+	}
+	req.ContentLength = length
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send the upload request: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("upload URL returned %s: %s", resp.Status, string(b))
+	}
+	return nil
+}
+
+// completeUploadExternal is step 3: finalize the upload and share it to a
+// channel, returning the file's ID, permalink, and url_private download
+// URL. That URL requires authentication to fetch; no public URL is returned.
+func (c *Client) completeUploadExternal(ctx context.Context, fileID, title, channelID, threadTS string) (*FileUploadResult, error) {
+	type fileArg struct {
+		ID    string `json:"id"`
+		Title string `json:"title,omitempty"`
+	}
+	body := struct {
+		Files     []fileArg `json:"files"`
+		ChannelID string    `json:"channel_id,omitempty"`
+		ThreadTS  string    `json:"thread_ts,omitempty"`
+	}{
+		Files:     []fileArg{{ID: fileID, Title: title}},
+		ChannelID: channelID,
+		ThreadTS:  threadTS,
+	}
+	var b bytes.Buffer
+	if err := json.NewEncoder(&b).Encode(&body); err != nil {
+		return nil, fmt.Errorf("could not encode the request to JSON: %s", err)
+	}
+
+	var out struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+		Files []struct {
+			ID        string `json:"id"`
+			Permalink string `json:"permalink"`
+			URLPriv   string `json:"url_private"`
+		} `json:"files"`
+	}
+	if err := c.callAPI(ctx, "files.completeUploadExternal", &b, "application/json; charset=utf-8", &out); err != nil {
+		return nil, err
+	}
+	if !out.OK {
+		return nil, fmt.Errorf("slack API error: %s", out.Error)
+	}
+	if len(out.Files) == 0 {
+		return nil, fmt.Errorf("slack API did not return the completed file")
+	}
+	return &FileUploadResult{
+		FileID:    out.Files[0].ID,
+		Permalink: out.Files[0].Permalink,
+		URL:       out.Files[0].URLPriv,
+	}, nil
+}
+
+// callAPI POSTs body to the given slack.com/api/* method, authenticated with
+// c.BotToken, and decodes the JSON response into out.
+func (c *Client) callAPI(ctx context.Context, method string, body io.Reader, contentType string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, slackAPIURL+"/"+method, body)
+	if err != nil {
+		return fmt.Errorf("could not build the request: %s", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.BotToken)
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send the request: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("Slack API returned %s: %s", resp.Status, string(b))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("could not decode the response: %s", err)
+	}
+	return nil
+}